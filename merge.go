@@ -11,7 +11,10 @@
 
 package excelize
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // Rect gets merged cell rectangle coordinates sequence.
 func (mc *xlsxMergeCell) Rect() ([]int, error) {
@@ -116,6 +119,174 @@ func (f *File) UnmergeCell(sheet string, hCell, vCell string) error {
 	return nil
 }
 
+// GetMergeCellAt provides a function to get the merged cell region that
+// contains a given cell reference. The returned boolean is false if the
+// cell is not part of any merged region. For example, look up the merge
+// that covers C4 on Sheet1:
+//
+//	mergeCell, ok, err := f.GetMergeCellAt("Sheet1", "C4")
+//
+// This walks ws.MergeCells directly on every call rather than keeping a
+// cross-call cache: ws.MergeCells.Cells can be mutated by code other than
+// MergeCell and UnmergeCell (InsertRows and friends shift merged cells in
+// place when rows or columns are inserted or deleted), and there's no
+// reliable hook here to invalidate a cache on every such mutation. Reading
+// it fresh trades away an O(1) lookup for a result that's always correct.
+func (f *File) GetMergeCellAt(sheet, cell string) (MergeCell, bool, error) {
+	col, row, err := CellNameToCoordinates(cell)
+	if err != nil {
+		return nil, false, err
+	}
+	ws, err := f.SRworkSheetReader(sheet)
+	if err != nil {
+		return nil, false, err
+	}
+	if ws.MergeCells == nil {
+		return nil, false, nil
+	}
+	if err = f.SRmergeOverlapCells(ws); err != nil {
+		return nil, false, err
+	}
+	for _, mergeCell := range ws.MergeCells.Cells {
+		if mergeCell == nil {
+			continue
+		}
+		rect, err := mergeCell.Rect()
+		if err != nil {
+			return nil, false, err
+		}
+		if col < rect[0] || col > rect[2] || row < rect[1] || row > rect[3] {
+			continue
+		}
+		axis := strings.Split(mergeCell.Ref, ":")[0]
+		val, err := f.SRGetCellValue(sheet, axis)
+		if err != nil {
+			return nil, false, err
+		}
+		return MergeCell{mergeCell.Ref, val}, true, nil
+	}
+	return nil, false, nil
+}
+
+// CopyRange provides a function to copy a rectangular range of cells within
+// the same worksheet, replicating cell values and styles, as well as any
+// merged cells that lie entirely within the source range, translating each
+// merged cell's coordinates by the offset between the source and
+// destination top-left corner. The destination range must describe an area
+// of the same size as the source range. For example copy A1:B2 to D1:E2 on
+// Sheet1:
+//
+//	err := f.CopyRange("Sheet1", "A1", "B2", "D1", "E2")
+//
+// A merged cell that only partially overlaps the source range is not
+// copied; CopyRange returns an error instead of silently truncating it.
+func (f *File) CopyRange(sheet, srcHCell, srcVCell, dstHCell, dstVCell string) error {
+	return f.copyRange(sheet, sheet, srcHCell, srcVCell, dstHCell, dstVCell)
+}
+
+// CopyRangeToSheet provides a function to copy a rectangular range of cells
+// from one worksheet to a same-sized range on another worksheet. See
+// CopyRange for the details of what gets copied.
+func (f *File) CopyRangeToSheet(srcSheet, dstSheet, srcHCell, srcVCell, dstHCell, dstVCell string) error {
+	return f.copyRange(srcSheet, dstSheet, srcHCell, srcVCell, dstHCell, dstVCell)
+}
+
+// copyRange copies cell values, styles and contained merged cells from a
+// rectangular range on srcSheet to a same-shaped range on dstSheet.
+func (f *File) copyRange(srcSheet, dstSheet, srcHCell, srcVCell, dstHCell, dstVCell string) error {
+	srcRect, err := areaRefToCoordinates(srcHCell + ":" + srcVCell)
+	if err != nil {
+		return err
+	}
+	dstRect, err := areaRefToCoordinates(dstHCell + ":" + dstVCell)
+	if err != nil {
+		return err
+	}
+	_ = sortCoordinates(srcRect)
+	_ = sortCoordinates(dstRect)
+	if srcRect[2]-srcRect[0] != dstRect[2]-dstRect[0] || srcRect[3]-srcRect[1] != dstRect[3]-dstRect[1] {
+		return fmt.Errorf("destination range %s:%s does not match the shape of source range %s:%s", dstHCell, dstVCell, srcHCell, srcVCell)
+	}
+	dx, dy := dstRect[0]-srcRect[0], dstRect[1]-srcRect[1]
+	// Read every source cell before writing any destination cell: for a
+	// same-sheet copy whose destination overlaps the source, writing as we
+	// go would clobber source cells that are still waiting to be read.
+	type cellSnapshot struct {
+		axis    string
+		value   string
+		styleID int
+	}
+	snapshot := make([]cellSnapshot, 0, (srcRect[2]-srcRect[0]+1)*(srcRect[3]-srcRect[1]+1))
+	for col := srcRect[0]; col <= srcRect[2]; col++ {
+		for row := srcRect[1]; row <= srcRect[3]; row++ {
+			srcAxis, err := CoordinatesToCellName(col, row)
+			if err != nil {
+				return err
+			}
+			dstAxis, err := CoordinatesToCellName(col+dx, row+dy)
+			if err != nil {
+				return err
+			}
+			value, err := f.GetCellValue(srcSheet, srcAxis)
+			if err != nil {
+				return err
+			}
+			styleID, err := f.GetCellStyle(srcSheet, srcAxis)
+			if err != nil {
+				return err
+			}
+			snapshot = append(snapshot, cellSnapshot{axis: dstAxis, value: value, styleID: styleID})
+		}
+	}
+	for _, cell := range snapshot {
+		if err := f.SetCellValue(dstSheet, cell.axis, cell.value); err != nil {
+			return err
+		}
+		if cell.styleID != 0 {
+			if err := f.SetCellStyle(dstSheet, cell.axis, cell.axis, cell.styleID); err != nil {
+				return err
+			}
+		}
+	}
+	return f.copyMergeCells(srcSheet, dstSheet, srcRect, dx, dy)
+}
+
+// copyMergeCells translates merged cell regions from srcSheet that lie
+// entirely within srcRect onto dstSheet by the given (dx,dy) offset,
+// creating each translated region via the same MergeCell machinery used
+// elsewhere in this file. A region that only partially overlaps srcRect
+// returns an error rather than being truncated.
+func (f *File) copyMergeCells(srcSheet, dstSheet string, srcRect []int, dx, dy int) error {
+	ws, err := f.workSheetReader(srcSheet)
+	if err != nil {
+		return err
+	}
+	if ws.MergeCells == nil {
+		return nil
+	}
+	for _, mergeCell := range ws.MergeCells.Cells {
+		if mergeCell == nil {
+			continue
+		}
+		rect, err := mergeCell.Rect()
+		if err != nil {
+			return err
+		}
+		if !isOverlap(srcRect, rect) {
+			continue
+		}
+		if rect[0] < srcRect[0] || rect[1] < srcRect[1] || rect[2] > srcRect[2] || rect[3] > srcRect[3] {
+			return fmt.Errorf("merged cell %s partially overlaps the source range and cannot be copied", mergeCell.Ref)
+		}
+		hCell, _ := CoordinatesToCellName(rect[0]+dx, rect[1]+dy)
+		vCell, _ := CoordinatesToCellName(rect[2]+dx, rect[3]+dy)
+		if err = f.MergeCell(dstSheet, hCell, vCell); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetMergeCells provides a function to get all merged cells from a worksheet
 // currently.
 func (f *File) GetMergeCells(sheet string) ([]MergeCell, error) {
@@ -0,0 +1,77 @@
+// Copyright 2016 - 2022 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+
+package excelize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMergeCellAt(t *testing.T) {
+	f := NewFile()
+	defer func() {
+		assert.NoError(t, f.Close())
+	}()
+	require.NoError(t, f.SetCellValue("Sheet1", "B2", "merged"))
+	require.NoError(t, f.MergeCell("Sheet1", "B2", "C3"))
+
+	mergeCell, ok, err := f.GetMergeCellAt("Sheet1", "C3")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "merged", mergeCell.GetCellValue())
+
+	// Repeated lookups should keep returning the same answer.
+	mergeCell, ok, err = f.GetMergeCellAt("Sheet1", "B2")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "B2", mergeCell.GetStartAxis())
+
+	_, ok, err = f.GetMergeCellAt("Sheet1", "D4")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// UnmergeCell must be reflected immediately.
+	require.NoError(t, f.UnmergeCell("Sheet1", "B2", "C3"))
+	_, ok, err = f.GetMergeCellAt("Sheet1", "C3")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// Merging a larger area over the same cells should be reflected
+	// immediately too.
+	require.NoError(t, f.MergeCell("Sheet1", "B2", "D4"))
+	_, ok, err = f.GetMergeCellAt("Sheet1", "D4")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestGetMergeCellAtAfterDirectInsertRows(t *testing.T) {
+	// GetMergeCellAt reads ws.MergeCells.Cells fresh on every call, so a
+	// caller that shifts merges via a pre-existing mutator like InsertRows
+	// - without going through ExpandRows or MergeCell/UnmergeCell at all -
+	// must still see the up-to-date merge position, not a stale one.
+	f := NewFile()
+	defer func() {
+		assert.NoError(t, f.Close())
+	}()
+	require.NoError(t, f.SetCellValue("Sheet1", "B2", "merged"))
+	require.NoError(t, f.MergeCell("Sheet1", "B2", "C3"))
+
+	_, ok, err := f.GetMergeCellAt("Sheet1", "B2")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, f.InsertRows("Sheet1", 1, 2))
+
+	_, ok, err = f.GetMergeCellAt("Sheet1", "B2")
+	assert.NoError(t, err)
+	assert.False(t, ok, "the merge should have shifted down, not still be reported at B2")
+
+	mergeCell, ok, err := f.GetMergeCellAt("Sheet1", "B4")
+	assert.NoError(t, err)
+	assert.True(t, ok, "the merge should have shifted down to B4:C5")
+	assert.Equal(t, "merged", mergeCell.GetCellValue())
+}
@@ -0,0 +1,83 @@
+// Copyright 2016 - 2022 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+
+package excelize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyRange(t *testing.T) {
+	f := NewFile()
+	defer func() {
+		assert.NoError(t, f.Close())
+	}()
+	require.NoError(t, f.SetCellValue("Sheet1", "A1", "a1"))
+	require.NoError(t, f.SetCellValue("Sheet1", "B1", "b1"))
+	require.NoError(t, f.SetCellValue("Sheet1", "A2", "a2"))
+	require.NoError(t, f.SetCellValue("Sheet1", "B2", "b2"))
+	require.NoError(t, f.MergeCell("Sheet1", "A1", "B1"))
+
+	assert.NoError(t, f.CopyRange("Sheet1", "A1", "B2", "D1", "E2"))
+	for _, expected := range [][2]string{{"D1", "a1"}, {"E1", ""}, {"D2", "a2"}, {"E2", "b2"}} {
+		val, err := f.GetCellValue("Sheet1", expected[0])
+		assert.NoError(t, err)
+		assert.Equal(t, expected[1], val)
+	}
+	mergeCell, ok, err := f.GetMergeCellAt("Sheet1", "D1")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "D1:E1", mergeCell.GetStartAxis()+":"+mergeCell.GetEndAxis())
+
+	// A destination that overlaps the source on the same sheet must not
+	// corrupt source cells that are read after earlier destination cells
+	// have already been written.
+	f2 := NewFile()
+	defer func() {
+		assert.NoError(t, f2.Close())
+	}()
+	require.NoError(t, f2.SetCellValue("Sheet1", "A1", "a1"))
+	require.NoError(t, f2.SetCellValue("Sheet1", "A2", "a2"))
+	require.NoError(t, f2.SetCellValue("Sheet1", "B1", "b1"))
+	require.NoError(t, f2.SetCellValue("Sheet1", "B2", "b2"))
+	assert.NoError(t, f2.CopyRange("Sheet1", "A1", "B2", "B1", "C2"))
+	for _, expected := range [][2]string{{"B1", "a1"}, {"C1", "b1"}, {"B2", "a2"}, {"C2", "b2"}} {
+		val, err := f2.GetCellValue("Sheet1", expected[0])
+		assert.NoError(t, err)
+		assert.Equal(t, expected[1], val)
+	}
+
+	// A merged cell that only partially overlaps the source range should
+	// error rather than being copied truncated.
+	f3 := NewFile()
+	defer func() {
+		assert.NoError(t, f3.Close())
+	}()
+	require.NoError(t, f3.MergeCell("Sheet1", "A1", "A3"))
+	assert.Error(t, f3.CopyRange("Sheet1", "A1", "B2", "D1", "E2"))
+
+	// Mismatched source/destination shapes should be rejected.
+	f4 := NewFile()
+	defer func() {
+		assert.NoError(t, f4.Close())
+	}()
+	assert.Error(t, f4.CopyRange("Sheet1", "A1", "B2", "D1", "D2"))
+
+	// CopyRangeToSheet copies across sheets.
+	f5 := NewFile()
+	defer func() {
+		assert.NoError(t, f5.Close())
+	}()
+	idx, err := f5.NewSheet("Sheet2")
+	require.NoError(t, err)
+	f5.SetActiveSheet(idx)
+	require.NoError(t, f5.SetCellValue("Sheet1", "A1", "x"))
+	assert.NoError(t, f5.CopyRangeToSheet("Sheet1", "Sheet2", "A1", "A1", "A1", "A1"))
+	val, err := f5.GetCellValue("Sheet2", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "x", val)
+}
@@ -0,0 +1,199 @@
+// Copyright 2016 - 2022 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+//
+// Package excelize providing a set of functions that allow you to write to and
+// read from XLAM / XLSM / XLSX / XLTM / XLTX files. Supports reading and
+// writing spreadsheet documents generated by Microsoft Excel™ 2007 and later.
+// Supports complex components by high compatibility, and provided streaming
+// API for generating or reading data from a worksheet with huge amounts of
+// data. This library needs Go version 1.15 or later.
+
+package excelize
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExpandRows provides a function to duplicate a template row band n times
+// downward, replicating cell values, styles, row heights and any merged
+// cells confined to the band. Rows already below the band, along with
+// their merged cells, are shifted down by n * bandHeight so the replicated
+// rows don't collide with them. anchor identifies the template band: a
+// single cell reference such as "A2" selects that one row, while a range
+// such as "A2:A4" selects a multi-row band (for example a report line that
+// spans several rows of merged cells). For example duplicate the single
+// row 2 on Sheet1 three times, filling in a running index for each copy:
+//
+//	err := f.ExpandRows("Sheet1", "A2", 3, func(row int) error {
+//	    return f.SetCellValue("Sheet1", fmt.Sprintf("A%d", row), row-1)
+//	})
+//
+// fill, when non-nil, is called once per replicated destination row, in
+// row order, so callers can overwrite placeholder cells with per-record
+// data after the template band has been copied into place. A merged cell
+// that spans past the edge of the template band returns an error instead
+// of being silently dropped.
+func (f *File) ExpandRows(sheet, anchor string, n int, fill func(row int) error) error {
+	if n <= 0 {
+		return fmt.Errorf("n should be greater than 0")
+	}
+	templateRow, bandHeight, err := parseTemplateBand(anchor)
+	if err != nil {
+		return err
+	}
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return err
+	}
+	if templateRow-1 >= len(rows) || templateRow+bandHeight-2 >= len(rows) {
+		return fmt.Errorf("template row band %s does not exist", anchor)
+	}
+	if err = f.checkBandMergeCells(sheet, templateRow, bandHeight); err != nil {
+		return err
+	}
+	rowHeights := make([]float64, bandHeight)
+	for band := 0; band < bandHeight; band++ {
+		if rowHeights[band], err = f.GetRowHeight(sheet, templateRow+band); err != nil {
+			return err
+		}
+	}
+	if err = f.InsertRows(sheet, templateRow+bandHeight, n*bandHeight); err != nil {
+		return err
+	}
+	for i := 1; i <= n; i++ {
+		rowOffset := i * bandHeight
+		for band := 0; band < bandHeight; band++ {
+			srcRow, destRow := templateRow+band, templateRow+band+rowOffset
+			var templateCells []string
+			if srcRow-1 < len(rows) {
+				templateCells = rows[srcRow-1]
+			}
+			for col := range templateCells {
+				srcAxis, err := CoordinatesToCellName(col+1, srcRow)
+				if err != nil {
+					return err
+				}
+				dstAxis, err := CoordinatesToCellName(col+1, destRow)
+				if err != nil {
+					return err
+				}
+				value, err := f.GetCellValue(sheet, srcAxis)
+				if err != nil {
+					return err
+				}
+				if err = f.SetCellValue(sheet, dstAxis, value); err != nil {
+					return err
+				}
+				styleID, err := f.GetCellStyle(sheet, srcAxis)
+				if err != nil {
+					return err
+				}
+				if styleID != 0 {
+					if err = f.SetCellStyle(sheet, dstAxis, dstAxis, styleID); err != nil {
+						return err
+					}
+				}
+			}
+			if err = f.SetRowHeight(sheet, destRow, rowHeights[band]); err != nil {
+				return err
+			}
+		}
+		if err = f.copyBandMergeCells(sheet, templateRow, bandHeight, rowOffset); err != nil {
+			return err
+		}
+		if fill != nil {
+			for band := 0; band < bandHeight; band++ {
+				if err = fill(templateRow + band + rowOffset); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// parseTemplateBand resolves an ExpandRows anchor to the top row of the
+// template band and the number of rows it spans. A plain cell reference
+// selects a single-row band; a "top:bottom" range selects every row from
+// top to bottom inclusive.
+func parseTemplateBand(anchor string) (templateRow, bandHeight int, err error) {
+	if !strings.Contains(anchor, ":") {
+		if _, templateRow, err = CellNameToCoordinates(anchor); err != nil {
+			return 0, 0, err
+		}
+		return templateRow, 1, nil
+	}
+	rect, err := areaRefToCoordinates(anchor)
+	if err != nil {
+		return 0, 0, err
+	}
+	_ = sortCoordinates(rect)
+	return rect[1], rect[3] - rect[1] + 1, nil
+}
+
+// checkBandMergeCells returns an error if any merged cell on sheet spans
+// past the edge of the template band [templateRow, templateRow+bandHeight),
+// since such a merge can't be replicated or shifted without truncating it.
+func (f *File) checkBandMergeCells(sheet string, templateRow, bandHeight int) error {
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	if ws.MergeCells == nil {
+		return nil
+	}
+	bandTop, bandBottom := templateRow, templateRow+bandHeight-1
+	for _, mergeCell := range ws.MergeCells.Cells {
+		if mergeCell == nil {
+			continue
+		}
+		rect, err := mergeCell.Rect()
+		if err != nil {
+			return err
+		}
+		if rect[3] < bandTop || rect[1] > bandBottom {
+			continue
+		}
+		if rect[1] < bandTop || rect[3] > bandBottom {
+			return fmt.Errorf("merged cell %s spans past the edge of the template row band and cannot be expanded", mergeCell.Ref)
+		}
+	}
+	return nil
+}
+
+// copyBandMergeCells translates merged cells confined to the template row
+// band onto a replicated band, using the same area-translation approach as
+// CopyRange: each candidate region is read via xlsxMergeCell.Rect, tested
+// for containment within the band, then recreated at the shifted
+// coordinates through MergeCell. checkBandMergeCells guarantees by this
+// point that no merge straddles the band edge.
+func (f *File) copyBandMergeCells(sheet string, templateRow, bandHeight, rowOffset int) error {
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	if ws.MergeCells == nil {
+		return nil
+	}
+	bandTop, bandBottom := templateRow, templateRow+bandHeight-1
+	for _, mergeCell := range ws.MergeCells.Cells {
+		if mergeCell == nil {
+			continue
+		}
+		rect, err := mergeCell.Rect()
+		if err != nil {
+			return err
+		}
+		if rect[1] < bandTop || rect[3] > bandBottom {
+			continue
+		}
+		hCell, _ := CoordinatesToCellName(rect[0], rect[1]+rowOffset)
+		vCell, _ := CoordinatesToCellName(rect[2], rect[3]+rowOffset)
+		if err = f.MergeCell(sheet, hCell, vCell); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2016 - 2022 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+
+package excelize
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandRowsSingleRow(t *testing.T) {
+	f := NewFile()
+	defer func() {
+		assert.NoError(t, f.Close())
+	}()
+	require.NoError(t, f.SetCellValue("Sheet1", "A1", "header"))
+	require.NoError(t, f.SetCellValue("Sheet1", "A2", "template"))
+	require.NoError(t, f.SetCellValue("Sheet1", "A3", "footer"))
+
+	var filled []int
+	assert.NoError(t, f.ExpandRows("Sheet1", "A2", 3, func(row int) error {
+		filled = append(filled, row)
+		return f.SetCellValue("Sheet1", fmt.Sprintf("B%d", row), row)
+	}))
+	assert.Equal(t, []int{3, 4, 5}, filled)
+
+	for _, row := range []string{"A3", "A4", "A5"} {
+		val, err := f.GetCellValue("Sheet1", row)
+		assert.NoError(t, err)
+		assert.Equal(t, "template", val)
+	}
+	footer, err := f.GetCellValue("Sheet1", "A6")
+	assert.NoError(t, err)
+	assert.Equal(t, "footer", footer)
+}
+
+func TestExpandRowsMultiRowBand(t *testing.T) {
+	f := NewFile()
+	defer func() {
+		assert.NoError(t, f.Close())
+	}()
+	require.NoError(t, f.SetCellValue("Sheet1", "A2", "line"))
+	require.NoError(t, f.SetCellValue("Sheet1", "A4", "after"))
+	// A merge spanning the whole two-row template band (A2:A3) must be
+	// replicated with each copy, not silently dropped.
+	require.NoError(t, f.MergeCell("Sheet1", "A2", "A3"))
+
+	assert.NoError(t, f.ExpandRows("Sheet1", "A2:A3", 2, nil))
+
+	// The band is inserted n*bandHeight = 4 rows below the template, so the
+	// two replicas land at rows 4:5 and 6:7, and the original A4 is pushed
+	// down to A8.
+	for _, span := range [][2]string{{"A2", "A3"}, {"A4", "A5"}, {"A6", "A7"}} {
+		mergeCell, ok, err := f.GetMergeCellAt("Sheet1", span[0])
+		assert.NoError(t, err)
+		assert.True(t, ok, "expected a merge at %s", span[0])
+		assert.Equal(t, span[0], mergeCell.GetStartAxis())
+		assert.Equal(t, span[1], mergeCell.GetEndAxis())
+	}
+	val, err := f.GetCellValue("Sheet1", "A6")
+	assert.NoError(t, err)
+	assert.Equal(t, "line", val)
+	after, err := f.GetCellValue("Sheet1", "A8")
+	assert.NoError(t, err)
+	assert.Equal(t, "after", after)
+}
+
+func TestExpandRowsMergeSpansPastBand(t *testing.T) {
+	f := NewFile()
+	defer func() {
+		assert.NoError(t, f.Close())
+	}()
+	require.NoError(t, f.SetCellValue("Sheet1", "A2", "template"))
+	require.NoError(t, f.MergeCell("Sheet1", "A2", "A4"))
+	// The merge A2:A4 spans past the single-row band A2:A2, so it must
+	// error instead of being silently truncated.
+	assert.Error(t, f.ExpandRows("Sheet1", "A2", 2, nil))
+}
+
+func TestExpandRowsInvalidAnchorDoesNotMutate(t *testing.T) {
+	f := NewFile()
+	defer func() {
+		assert.NoError(t, f.Close())
+	}()
+	require.NoError(t, f.SetCellValue("Sheet1", "A1", "only row"))
+
+	before, err := f.GetRows("Sheet1")
+	require.NoError(t, err)
+	assert.Error(t, f.ExpandRows("Sheet1", "A5", 2, nil))
+	after, err := f.GetRows("Sheet1")
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+}